@@ -0,0 +1,184 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HTTPProxy is an Ingress CRD specification.
+type HTTPProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPProxySpec   `json:"spec"`
+	Status HTTPProxyStatus `json:"status,omitempty"`
+}
+
+// HTTPProxySpec defines the spec of the CRD.
+type HTTPProxySpec struct {
+	// VirtualHost appears at most once. If it is present, the object is
+	// considered to be "root".
+	//
+	// +optional
+	VirtualHost *VirtualHost `json:"virtualhost,omitempty"`
+
+	// Routes are the ingress routes. If TCPProxy is present, Routes is
+	// ignored.
+	//
+	// +optional
+	Routes []Route `json:"routes,omitempty"`
+}
+
+// VirtualHost appears at most once per HTTPProxy root document, and
+// describes properties of the domain for which the document is
+// authoritative.
+type VirtualHost struct {
+	// Fqdn is the fully qualified domain name used to match requests.
+	Fqdn string `json:"fqdn"`
+
+	// TLS configures TLS termination for this virtual host.
+	//
+	// +optional
+	TLS *TLS `json:"tls,omitempty"`
+}
+
+// TLS describes tls properties for a VirtualHost.
+type TLS struct {
+	// SecretName is the name of a Kubernetes Secret holding the TLS
+	// certificate and private key to serve this virtual host.
+	//
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// MinimumProtocolVersion is the minimum TLS version this vhost
+	// negotiates. One of "1.2" or "1.3". Defaults to "1.2".
+	//
+	// +optional
+	MinimumProtocolVersion string `json:"minimumProtocolVersion,omitempty"`
+
+	// CertificateSource allows the serving certificate to be sourced
+	// from something other than a Kubernetes Secret, such as a
+	// filesystem path populated by a CSI driver or sidecar. Mutually
+	// exclusive with SecretName.
+	//
+	// +optional
+	CertificateSource *CertificateSource `json:"certificateSource,omitempty"`
+}
+
+// Route contains the set of routes for a virtual host.
+type Route struct {
+	// Conditions are a set of routing properties that is applied to an
+	// HTTPProxy in a namespace.
+	//
+	// +optional
+	Conditions []MatchCondition `json:"conditions,omitempty"`
+
+	// Services are the services to proxy traffic to matching this
+	// route.
+	Services []Service `json:"services,omitempty"`
+
+	// TracingPolicy overrides the virtual-host or global tracing
+	// defaults for requests matched by this route.
+	//
+	// +optional
+	TracingPolicy *TracingPolicy `json:"tracingPolicy,omitempty"`
+}
+
+// MatchCondition are the match conditions for a route.
+type MatchCondition struct {
+	// Prefix defines a prefix match for a request.
+	//
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// HeaderRegex defines a header match for a request where the
+	// header's value is matched against an RE2 regular expression.
+	//
+	// +optional
+	HeaderRegex *HeaderRegexMatchCondition `json:"headerRegex,omitempty"`
+}
+
+// HeaderRegexMatchCondition matches a request header's value against
+// a regular expression.
+type HeaderRegexMatchCondition struct {
+	// Name is the name of the header to match against.
+	Name string `json:"name"`
+
+	// Regex is the RE2 regular expression the header's value must
+	// match.
+	Regex string `json:"regex"`
+}
+
+// Service defines an Kubernetes Service to proxy traffic.
+type Service struct {
+	// Name is the name of Kubernetes service to proxy traffic.
+	Name string `json:"name"`
+
+	// Port is the port for this service.
+	Port int `json:"port"`
+
+	// Registry, if set, indicates this service's endpoints are sourced
+	// from the named ServiceRegistry rather than a Kubernetes Service
+	// with a matching name.
+	//
+	// +optional
+	Registry *ServiceRegistryRef `json:"registry,omitempty"`
+}
+
+// ServiceRegistryRef names the ServiceRegistry that sources endpoints
+// for a Service entry.
+type ServiceRegistryRef struct {
+	// Name is the name of the ServiceRegistry in the same namespace as
+	// the HTTPProxy.
+	Name string `json:"name"`
+}
+
+// HTTPProxyStatus reports the current state of the HTTPProxy.
+type HTTPProxyStatus struct {
+	// Conditions contains information about the current status of the
+	// HTTPProxy, in an upstream-friendly container.
+	//
+	// +optional
+	Conditions []DetailedCondition `json:"conditions,omitempty"`
+}
+
+// DetailedCondition is an extension of metav1.Condition that carries a
+// slice of SubConditions with additional detail on the overall
+// condition.
+type DetailedCondition struct {
+	metav1.Condition `json:",inline"`
+
+	Errors []SubCondition `json:"errors,omitempty"`
+}
+
+// SubCondition is a piece of detail backing up a DetailedCondition.
+type SubCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// GetConditionFor returns the a DetailedCondition for a given condition
+// type, or an empty DetailedCondition if one is not found.
+func (status *HTTPProxyStatus) GetConditionFor(conditionType string) DetailedCondition {
+	for _, cond := range status.Conditions {
+		if cond.Type == conditionType {
+			return cond
+		}
+	}
+
+	return DetailedCondition{}
+}
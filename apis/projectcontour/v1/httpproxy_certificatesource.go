@@ -0,0 +1,43 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// CertificateSource selects an alternative source of TLS certificate
+// material for a VirtualHost, for integrations (cert-manager CSI,
+// SPIRE, Vault Agent) that render certificates to a filesystem path
+// instead of a Kubernetes Secret.
+type CertificateSource struct {
+	// FileCertificate references a FileCertificate naming the on-disk
+	// path Envoy should watch for the certificate and private key.
+	//
+	// +optional
+	FileCertificate *FileCertificate `json:"fileCertificate,omitempty"`
+}
+
+// FileCertificate names a filesystem path, mounted into the Envoy
+// container, that Contour should configure as a file-watched SDS
+// source rather than pushing the certificate body over xDS.
+type FileCertificate struct {
+	// Name identifies this FileCertificate so it can be referenced from
+	// a VirtualHost's CertificateSource.
+	Name string `json:"name"`
+
+	// CertificatePath is the path to the PEM-encoded certificate chain
+	// on disk, as seen by the Envoy container.
+	CertificatePath string `json:"certificatePath"`
+
+	// PrivateKeyPath is the path to the PEM-encoded private key on
+	// disk, as seen by the Envoy container.
+	PrivateKeyPath string `json:"privateKeyPath"`
+}
@@ -0,0 +1,108 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// TracingPolicy defines the per-route overrides of the global tracing
+// configuration. It is set on a Route; VirtualHost has no TracingPolicy
+// field. It is consulted by the DAG builder when a Route carries it,
+// falling back to the ContourConfiguration's Tracing defaults
+// otherwise.
+type TracingPolicy struct {
+	// OverallSampling sets the sampling rate, as a percentage, for this
+	// route. Overrides the globally configured sampling percentage.
+	//
+	// +optional
+	OverallSampling string `json:"overallSampling,omitempty"`
+
+	// MaxPathTagLength sets the maximum length of the request path to
+	// include in the generated trace tag. Overrides the globally
+	// configured value.
+	//
+	// +optional
+	MaxPathTagLength *uint32 `json:"maxPathTagLength,omitempty"`
+
+	// CustomTags defines a list of tags to add to traced requests
+	// matching this route, in addition to any tags defined globally.
+	//
+	// +optional
+	CustomTags []CustomTag `json:"customTags,omitempty"`
+}
+
+// CustomTagType is the source of a CustomTag's value.
+type CustomTagType string
+
+const (
+	// LiteralCustomTagType produces a tag whose value is a fixed
+	// literal string.
+	LiteralCustomTagType CustomTagType = "Literal"
+	// EnvironmentCustomTagType names a tag whose value comes from an
+	// environment variable on the Envoy process. The DAG builder does
+	// not translate such tags into Envoy configuration.
+	EnvironmentCustomTagType CustomTagType = "Environment"
+	// RequestHeaderCustomTagType produces a tag whose value is read
+	// from a request header.
+	RequestHeaderCustomTagType CustomTagType = "RequestHeader"
+)
+
+// CustomTag defines a tag added to a trace span, and the source of its
+// value.
+type CustomTag struct {
+	// TagName is the name under which the tag is recorded on the span.
+	TagName string `json:"tagName"`
+
+	// Literal is a fixed string value for the tag.
+	//
+	// +optional
+	Literal string `json:"literal,omitempty"`
+
+	// Environment names an environment variable on the Envoy process
+	// whose value becomes the tag value. The DAG builder does not read
+	// Environment: a tag that sets only Environment is emitted as a
+	// literal tag with an empty value.
+	//
+	// +optional
+	Environment *EnvironmentCustomTag `json:"environment,omitempty"`
+
+	// RequestHeader names a request header whose value becomes the
+	// tag value.
+	//
+	// +optional
+	RequestHeader *RequestHeaderCustomTag `json:"requestHeader,omitempty"`
+}
+
+// EnvironmentCustomTag reads a custom tag value from an environment
+// variable on the Envoy process.
+type EnvironmentCustomTag struct {
+	// Name is the name of the environment variable.
+	Name string `json:"name"`
+
+	// DefaultValue names a value for when the environment variable is
+	// unset. Like Environment itself, the DAG builder does not read it.
+	//
+	// +optional
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+// RequestHeaderCustomTag reads a custom tag value from an incoming
+// request header.
+type RequestHeaderCustomTag struct {
+	// Name is the name of the request header.
+	Name string `json:"name"`
+
+	// DefaultValue names a value for requests that lack the header.
+	// The DAG builder does not carry it into the Envoy configuration.
+	//
+	// +optional
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
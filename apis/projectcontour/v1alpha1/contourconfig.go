@@ -0,0 +1,117 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ContourConfiguration is the schema for a Contour instance's runtime
+// configuration.
+type ContourConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ContourConfigurationSpec `json:"spec"`
+}
+
+// NamespacedName identifies a Kubernetes object by namespace and name.
+type NamespacedName struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// ContourConfigurationSpec defines the configuration for a Contour
+// instance.
+type ContourConfigurationSpec struct {
+	// Envoy holds Envoy-related configuration, such as the client
+	// certificate Contour presents to backends for TLS origination.
+	//
+	// +optional
+	Envoy *EnvoyConfig `json:"envoy,omitempty"`
+
+	// HTTPProxy holds HTTPProxy-related configuration, such as the
+	// fallback certificate used for SNI-less requests.
+	//
+	// +optional
+	HTTPProxy *HTTPProxyConfig `json:"httpproxy,omitempty"`
+
+	// EnableExternalNameService enables HTTPProxy routes to Kubernetes
+	// ExternalName services. Disabled by default to reduce the risk of
+	// SSRF attacks.
+	//
+	// +optional
+	EnableExternalNameService bool `json:"enableExternalNameService,omitempty"`
+
+	// RateLimitService configures the global rate limit extension
+	// service Envoy calls out to.
+	//
+	// +optional
+	RateLimitService *RateLimitServiceConfig `json:"rateLimitService,omitempty"`
+
+	// Tracing configures a distributed tracing backend that Contour
+	// configures into Envoy's HTTP connection manager.
+	//
+	// +optional
+	Tracing *TracingConfig `json:"tracing,omitempty"`
+
+	// RefreshPolicy configures how frequently Contour polls external
+	// integrations (certificates, extension services, rate limit
+	// config) that are not otherwise watched via informers.
+	//
+	// +optional
+	RefreshPolicy *RefreshPolicy `json:"refreshPolicy,omitempty"`
+}
+
+// EnvoyConfig holds Envoy-related configuration.
+type EnvoyConfig struct {
+	// ClientCertificate is the optional identifier of the TLS secret
+	// containing the client certificate and private key Envoy should
+	// use when originating TLS connections to backends.
+	//
+	// +optional
+	ClientCertificate *NamespacedName `json:"clientCertificate,omitempty"`
+}
+
+// HTTPProxyConfig holds HTTPProxy-related configuration.
+type HTTPProxyConfig struct {
+	// FallbackCertificate identifies the TLS secret to use for
+	// SNI-less requests, if configured.
+	//
+	// +optional
+	FallbackCertificate *NamespacedName `json:"fallbackCertificate,omitempty"`
+}
+
+// RateLimitServiceConfig defines the global rate limit extension
+// service to call out to.
+type RateLimitServiceConfig struct {
+	// ExtensionService identifies the extension service backing the
+	// rate limit service.
+	ExtensionService NamespacedName `json:"extensionService"`
+
+	// Domain is passed to the rate limit service in each request.
+	Domain string `json:"domain"`
+
+	// FailOpen determines whether to allow requests to proceed when
+	// the rate limit service is unavailable.
+	//
+	// +optional
+	FailOpen bool `json:"failOpen,omitempty"`
+
+	// EnableXRateLimitHeaders enables the X-RateLimit-* response
+	// headers.
+	//
+	// +optional
+	EnableXRateLimitHeaders bool `json:"enableXRateLimitHeaders,omitempty"`
+}
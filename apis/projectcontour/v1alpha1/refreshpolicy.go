@@ -0,0 +1,57 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RefreshPolicy configures how often Contour polls external
+// integrations that aren't otherwise watched via a Kubernetes
+// informer: cert-manager Certificates backing the fallback/client
+// certificates, ExtensionService health, and the rate limit service's
+// descriptor config.
+type RefreshPolicy struct {
+	// Certificate configures the poll interval for cert-manager
+	// Certificates used as the fallback or backend client certificate.
+	//
+	// +optional
+	Certificate *RefreshInterval `json:"certificate,omitempty"`
+
+	// ExtensionService configures the poll interval for
+	// ExtensionService health probes.
+	//
+	// +optional
+	ExtensionService *RefreshInterval `json:"extensionService,omitempty"`
+
+	// RateLimitConfig configures the poll interval for the rate limit
+	// service's descriptor ConfigMap.
+	//
+	// +optional
+	RateLimitConfig *RefreshInterval `json:"rateLimitConfig,omitempty"`
+}
+
+// RefreshInterval sets how often a single integration type is polled.
+// A Min outside the safe range (internal/refresh.MinInterval to
+// internal/refresh.MaxInterval) is clamped into it, with a warning
+// logged, rather than rejected outright.
+type RefreshInterval struct {
+	// Min is the interval between polls.
+	Min metav1.Duration `json:"min"`
+
+	// Max is the maximum interval between polls. internal/refresh does
+	// not read it: polls run every Min, whether or not they fail, with
+	// no backoff.
+	Max metav1.Duration `json:"max"`
+}
@@ -0,0 +1,79 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceRegistry names an external service catalog (Consul, Nomad, or
+// a generic HTTP endpoint) that HTTPProxy routes can source endpoints
+// from via Service.Registry, instead of a Kubernetes Service.
+type ServiceRegistry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceRegistrySpec   `json:"spec"`
+	Status ServiceRegistryStatus `json:"status,omitempty"`
+}
+
+// ServiceRegistrySpec configures how Contour polls an external catalog
+// for service endpoints.
+type ServiceRegistrySpec struct {
+	// Endpoint is the base URL of the catalog. Contour issues a GET
+	// against Endpoint plus the HTTPProxy Service name to retrieve a
+	// JSON array of `{address, port, tags}` entries.
+	Endpoint string `json:"endpoint"`
+
+	// RefreshInterval is how often Contour polls Endpoint for changes.
+	// Defaults to 30s.
+	//
+	// +optional
+	RefreshInterval metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// RequireConsistent requests a strongly consistent read from the
+	// catalog, at the cost of higher latency and load on it.
+	//
+	// +optional
+	RequireConsistent bool `json:"requireConsistent,omitempty"`
+
+	// Stale allows the catalog to serve the request from any replica,
+	// trading off read-after-write consistency for lower latency and
+	// load. Mutually exclusive with RequireConsistent.
+	//
+	// +optional
+	Stale bool `json:"stale,omitempty"`
+
+	// Cache configures a local response cache so repeated lookups for
+	// the same Service don't all hit the catalog.
+	//
+	// +optional
+	Cache *ServiceRegistryCache `json:"cache,omitempty"`
+}
+
+// ServiceRegistryCache configures the local response cache in front of
+// the external catalog.
+type ServiceRegistryCache struct {
+	// TTL is how long a cached catalog response is served before it's
+	// considered stale and re-fetched.
+	TTL metav1.Duration `json:"ttl"`
+}
+
+// ServiceRegistryStatus reports the catalog's reachability.
+type ServiceRegistryStatus struct {
+	// Conditions describes the observed state of the ServiceRegistry.
+	//
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
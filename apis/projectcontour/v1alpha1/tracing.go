@@ -0,0 +1,94 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// TracingConfig defines the global distributed tracing configuration
+// for a Contour instance, consumed by the DAG/xDS translator to
+// produce Envoy's HTTP connection manager `tracing` config.
+type TracingConfig struct {
+	// ExtensionService identifies the ExtensionService fronting the
+	// tracing collector (e.g. an OpenTelemetry or Zipkin collector).
+	ExtensionService *NamespacedName `json:"extensionService"`
+
+	// Provider selects the tracing backend protocol Contour configures
+	// Envoy to speak to the collector. One of "OpenTelemetry",
+	// "Zipkin", or "Datadog". Defaults to "OpenTelemetry".
+	//
+	// +optional
+	Provider TracingProvider `json:"provider,omitempty"`
+
+	// ServiceName is the service name reported in emitted spans.
+	// Defaults to "contour".
+	//
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// MaxPathTagLength is the maximum length of the request path
+	// included in the generated "http.url" tag.
+	//
+	// +optional
+	MaxPathTagLength uint32 `json:"maxPathTagLength,omitempty"`
+
+	// OverallSampling is the percentage of requests, as a string
+	// (e.g. "100", "0.1"), that are randomly selected for tracing.
+	// Defaults to "100".
+	//
+	// +optional
+	OverallSampling string `json:"overallSampling,omitempty"`
+
+	// CustomTags are tags added to every span emitted by Envoy, in
+	// addition to any HTTPProxy route-level TracingPolicy tags.
+	//
+	// +optional
+	CustomTags []CustomTag `json:"customTags,omitempty"`
+}
+
+// TracingProvider identifies the wire protocol Contour configures
+// Envoy to use when exporting spans to the collector.
+type TracingProvider string
+
+const (
+	// OpenTelemetryTracingProvider exports spans via the OpenTelemetry
+	// gRPC exporter.
+	OpenTelemetryTracingProvider TracingProvider = "OpenTelemetry"
+	// ZipkinTracingProvider exports spans via Envoy's native Zipkin
+	// tracer.
+	ZipkinTracingProvider TracingProvider = "Zipkin"
+	// DatadogTracingProvider exports spans via Envoy's native Datadog
+	// tracer.
+	DatadogTracingProvider TracingProvider = "Datadog"
+)
+
+// CustomTagType mirrors the tag-source discriminator used in the
+// HTTPProxy v1 TracingPolicy.
+type CustomTagType string
+
+// CustomTag defines a tag added to every emitted span. Its shape
+// differs from the HTTPProxy v1 CustomTag: the request header is named
+// directly by RequestHeaderName, and there is no environment source.
+type CustomTag struct {
+	// TagName is the name under which the tag is recorded on the span.
+	TagName string `json:"tagName"`
+
+	// Literal is a fixed string value for the tag.
+	//
+	// +optional
+	Literal string `json:"literal,omitempty"`
+
+	// RequestHeaderName names a request header whose value becomes the
+	// tag value.
+	//
+	// +optional
+	RequestHeaderName string `json:"requestHeaderName,omitempty"`
+}
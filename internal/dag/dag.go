@@ -0,0 +1,46 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dag builds a directed acyclic graph of the proxying and
+// routing configuration requested via Kubernetes objects (HTTPProxy,
+// Secret, Service, and the Contour-specific CRDs that refine them),
+// ready to be translated into Envoy xDS resources.
+package dag
+
+// VirtualHost represents the root of a tree of routes, keyed by
+// hostname.
+type VirtualHost struct {
+	// Name is the fully qualified domain name of the virtual host.
+	Name string
+
+	// TLS holds the virtual host's negotiated TLS settings, or nil if
+	// the virtual host is not secured.
+	TLS *TLSDetails
+}
+
+// TLSDetails holds the resolved TLS configuration for a VirtualHost,
+// after any TenantProfile defaults and HTTPProxy overrides have been
+// applied.
+type TLSDetails struct {
+	// SecretName identifies the Kubernetes Secret backing this virtual
+	// host's certificate, or empty if FileSecret is set instead.
+	SecretName string
+
+	// FileSecret is the file-backed certificate for this virtual host,
+	// or nil if SecretName is set instead.
+	FileSecret *FileSecret
+
+	// MinimumProtocolVersion is the minimum TLS version this vhost
+	// negotiates.
+	MinimumProtocolVersion string
+}
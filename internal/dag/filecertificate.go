@@ -0,0 +1,48 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dag
+
+import (
+	contour_api_v1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+)
+
+// FileSecret is the DAG's representation of a VirtualHost's serving
+// certificate when it is sourced from disk (a FileCertificate) rather
+// than a Kubernetes Secret. The xDS translator uses it to build a
+// file-watched SDS source instead of pushing certificate bytes over
+// xDS, so Envoy picks up rotations written to these paths directly.
+type FileSecret struct {
+	// Name identifies the FileCertificate this secret was built from,
+	// used to name the SDS secret resource.
+	Name string
+
+	CertificatePath string
+	PrivateKeyPath  string
+}
+
+// fileSecretFor resolves a VirtualHost TLS's CertificateSource into a
+// FileSecret, or returns nil if the TLS block does not reference a
+// FileCertificate (e.g. it uses a plain SecretName instead).
+func fileSecretFor(tls *contour_api_v1.TLS) *FileSecret {
+	if tls == nil || tls.CertificateSource == nil || tls.CertificateSource.FileCertificate == nil {
+		return nil
+	}
+
+	fc := tls.CertificateSource.FileCertificate
+	return &FileSecret{
+		Name:            fc.Name,
+		CertificatePath: fc.CertificatePath,
+		PrivateKeyPath:  fc.PrivateKeyPath,
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dag
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	"github.com/projectcontour/contour/internal/registry"
+)
+
+// RegistryEndpoint is the DAG's representation of a single backend
+// resolved from a ServiceRegistry, ready to be translated into an EDS
+// LbEndpoint by the xDS translator.
+type RegistryEndpoint struct {
+	Address string
+	Port    int
+}
+
+// RegistryEndpointsFor converts the endpoints a registry.Poller
+// resolved for a service into the DAG's endpoint representation, so
+// the cluster builder doesn't need to know about the registry
+// package's wire format.
+func RegistryEndpointsFor(endpoints []registry.Endpoint) []RegistryEndpoint {
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	out := make([]RegistryEndpoint, 0, len(endpoints))
+	for _, e := range endpoints {
+		out = append(out, RegistryEndpoint{
+			Address: e.Address,
+			Port:    e.Port,
+		})
+	}
+
+	return out
+}
+
+// EndpointCache holds the most recently observed endpoints for each
+// ServiceRegistry-backed service, kept up to date by a background
+// WatchRegistry loop rather than only refreshed when something else
+// happens to rebuild the DAG. This is what lets catalog-only endpoint
+// churn -- with no accompanying Kubernetes object change -- reach a
+// running Contour: WatchRegistry's resync callback triggers a rebuild,
+// and that rebuild reads the already-current cache instead of racing
+// a synchronous catalog fetch.
+type EndpointCache struct {
+	mu    sync.RWMutex
+	byKey map[string][]RegistryEndpoint
+}
+
+// NewEndpointCache returns an empty EndpointCache.
+func NewEndpointCache() *EndpointCache {
+	return &EndpointCache{byKey: map[string][]RegistryEndpoint{}}
+}
+
+// EndpointsFor returns the most recently observed endpoints for
+// serviceName as resolved against registry, or nil if WatchRegistry
+// hasn't completed a poll for it yet.
+func (c *EndpointCache) EndpointsFor(registryNamespace, registryName, serviceName string) []RegistryEndpoint {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.byKey[endpointCacheKey(registryNamespace, registryName, serviceName)]
+}
+
+// WatchRegistry starts a background poll loop (via poller.Watch) that
+// keeps the cache's entry for serviceName up to date, invoking resync
+// whenever the resolved endpoints change so the caller can trigger a
+// DAG rebuild. It blocks until ctx is canceled.
+func (c *EndpointCache) WatchRegistry(ctx context.Context, poller *registry.Poller, reg *contour_api_v1alpha1.ServiceRegistry, serviceName string, interval time.Duration, resync func()) {
+	key := endpointCacheKey(reg.Namespace, reg.Name, serviceName)
+
+	poller.Watch(ctx, reg, serviceName, interval, func(endpoints []registry.Endpoint) {
+		c.mu.Lock()
+		c.byKey[key] = RegistryEndpointsFor(endpoints)
+		c.mu.Unlock()
+
+		resync()
+	})
+}
+
+func endpointCacheKey(registryNamespace, registryName, serviceName string) string {
+	return registryNamespace + "/" + registryName + "/" + serviceName
+}
@@ -0,0 +1,73 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dag
+
+import (
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+)
+
+// TenantProfileCache indexes TenantProfiles by the namespace they are
+// bound to, so the DAG builder can look up the profile governing a
+// HTTPProxy's namespace in O(1) while processing it. It is rebuilt
+// from the informer cache at the start of each DAG build.
+type TenantProfileCache struct {
+	byNamespace map[string]*contour_api_v1alpha1.TenantProfile
+}
+
+// NewTenantProfileCache indexes the given TenantProfiles by namespace.
+// If more than one profile claims the same namespace, that namespace
+// is left unbound (neither profile is applied to it) rather than
+// picking one arbitrarily, since that would make the winner dependent
+// on informer ordering.
+func NewTenantProfileCache(profiles []*contour_api_v1alpha1.TenantProfile) *TenantProfileCache {
+	byNamespace := map[string]*contour_api_v1alpha1.TenantProfile{}
+	claimed := map[string]bool{}
+
+	for _, p := range profiles {
+		for _, ns := range p.Spec.Namespaces {
+			if claimed[ns] {
+				delete(byNamespace, ns)
+				continue
+			}
+			byNamespace[ns] = p
+			claimed[ns] = true
+		}
+	}
+
+	return &TenantProfileCache{byNamespace: byNamespace}
+}
+
+// ProfileFor returns the TenantProfile bound to namespace, or nil if
+// the namespace is not claimed by exactly one profile.
+func (c *TenantProfileCache) ProfileFor(namespace string) *contour_api_v1alpha1.TenantProfile {
+	if c == nil {
+		return nil
+	}
+	return c.byNamespace[namespace]
+}
+
+// ApplyTenantDefaults fills in the TLS minimum protocol version on vhost
+// from profile when the HTTPProxy didn't set one explicitly. It is
+// called once per VirtualHost while building the DAG, after the
+// HTTPProxy's own TLS settings have already been applied, so an
+// explicit per-HTTPProxy setting always wins over the tenant default.
+func ApplyTenantDefaults(vhost *VirtualHost, profile *contour_api_v1alpha1.TenantProfile) {
+	if profile == nil || vhost == nil || vhost.TLS == nil {
+		return
+	}
+
+	if vhost.TLS.MinimumProtocolVersion == "" {
+		vhost.TLS.MinimumProtocolVersion = profile.Spec.TLSMinimumProtocolVersion
+	}
+}
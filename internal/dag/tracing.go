@@ -0,0 +1,140 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dag
+
+import (
+	contour_api_v1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+)
+
+// TracingConfig is the DAG's normalized view of the tracing backend
+// configured globally for a Contour instance. It is attached to the
+// root of the DAG so the xDS translator can build the listener-level
+// tracing provider once, regardless of how many routes override
+// sampling or tags.
+type TracingConfig struct {
+	ExtensionServiceName      string
+	ExtensionServiceNamespace string
+	Provider                  contour_api_v1alpha1.TracingProvider
+	ServiceName               string
+	MaxPathTagLength          uint32
+	OverallSampling           string
+	CustomTags                []CustomTag
+}
+
+// CustomTag is the DAG's normalized view of a tracing custom tag,
+// unifying the global (ContourConfiguration) and per-route (HTTPProxy)
+// representations into a single shape the xDS translator understands.
+type CustomTag struct {
+	TagName           string
+	Literal           string
+	RequestHeaderName string
+}
+
+// TracingPolicy is the DAG's normalized view of a route's tracing
+// overrides.
+type TracingPolicy struct {
+	OverallSampling  string
+	MaxPathTagLength uint32
+	CustomTags       []CustomTag
+}
+
+// NewTracingConfig builds the DAG-level TracingConfig from the
+// ContourConfiguration's Tracing spec. It returns nil if tracing is not
+// configured, in which case the xDS translator omits the HCM tracing
+// block entirely.
+func NewTracingConfig(spec *contour_api_v1alpha1.TracingConfig) *TracingConfig {
+	if spec == nil || spec.ExtensionService == nil {
+		return nil
+	}
+
+	provider := spec.Provider
+	if provider == "" {
+		provider = contour_api_v1alpha1.OpenTelemetryTracingProvider
+	}
+
+	serviceName := spec.ServiceName
+	if serviceName == "" {
+		serviceName = "contour"
+	}
+
+	sampling := spec.OverallSampling
+	if sampling == "" {
+		sampling = "100"
+	}
+
+	return &TracingConfig{
+		ExtensionServiceName:      spec.ExtensionService.Name,
+		ExtensionServiceNamespace: spec.ExtensionService.Namespace,
+		Provider:                  provider,
+		ServiceName:               serviceName,
+		MaxPathTagLength:          spec.MaxPathTagLength,
+		OverallSampling:           sampling,
+		CustomTags:                convertGlobalCustomTags(spec.CustomTags),
+	}
+}
+
+// tracingPolicy builds a DAG TracingPolicy from a HTTPProxy route's
+// TracingPolicy, or nil if the route does not override tracing.
+func tracingPolicy(policy *contour_api_v1.TracingPolicy) *TracingPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	var maxPathTagLength uint32
+	if policy.MaxPathTagLength != nil {
+		maxPathTagLength = *policy.MaxPathTagLength
+	}
+
+	return &TracingPolicy{
+		OverallSampling:  policy.OverallSampling,
+		MaxPathTagLength: maxPathTagLength,
+		CustomTags:       convertRouteCustomTags(policy.CustomTags),
+	}
+}
+
+func convertGlobalCustomTags(tags []contour_api_v1alpha1.CustomTag) []CustomTag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	out := make([]CustomTag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, CustomTag{
+			TagName:           t.TagName,
+			Literal:           t.Literal,
+			RequestHeaderName: t.RequestHeaderName,
+		})
+	}
+	return out
+}
+
+func convertRouteCustomTags(tags []contour_api_v1.CustomTag) []CustomTag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	out := make([]CustomTag, 0, len(tags))
+	for _, t := range tags {
+		tag := CustomTag{
+			TagName: t.TagName,
+			Literal: t.Literal,
+		}
+		if t.RequestHeader != nil {
+			tag.RequestHeaderName = t.RequestHeader.Name
+		}
+		out = append(out, tag)
+	}
+	return out
+}
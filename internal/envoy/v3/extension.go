@@ -0,0 +1,24 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import "fmt"
+
+// ExtensionClusterName returns the cluster name Contour uses for an
+// ExtensionService-backed cluster (rate limit, external auth, tracing
+// collector), so every caller that needs to reference the cluster by
+// name agrees on its shape.
+func ExtensionClusterName(namespace, name string) string {
+	return fmt.Sprintf("extension/%s/%s", namespace, name)
+}
@@ -0,0 +1,61 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"path/filepath"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+
+	"github.com/projectcontour/contour/internal/dag"
+)
+
+// FileWatchedSecret builds a TlsCertificate SDS secret that Envoy
+// watches directly on disk, for a VirtualHost whose TLS material comes
+// from a FileSecret rather than a Kubernetes Secret pushed over xDS.
+// Because the certificate and key are DataSource file references
+// rather than inline bytes, Envoy's filesystem watcher reloads them in
+// place whenever the CSI driver/sidecar rewrites the files, with no
+// further xDS push required.
+func FileWatchedSecret(secret *dag.FileSecret) *envoy_tls_v3.Secret {
+	if secret == nil {
+		return nil
+	}
+
+	return &envoy_tls_v3.Secret{
+		Name: secret.Name,
+		Type: &envoy_tls_v3.Secret_TlsCertificate{
+			TlsCertificate: &envoy_tls_v3.TlsCertificate{
+				CertificateChain: fileDataSource(secret.CertificatePath),
+				PrivateKey:       fileDataSource(secret.PrivateKeyPath),
+			},
+		},
+	}
+}
+
+// fileDataSource builds a DataSource that Envoy watches for changes on
+// the containing directory, so a CSI driver or sidecar rewriting the
+// file in place (as cert-manager CSI, SPIRE, and Vault Agent do)
+// triggers a reload without an xDS push.
+func fileDataSource(path string) *envoy_core_v3.DataSource {
+	return &envoy_core_v3.DataSource{
+		Specifier: &envoy_core_v3.DataSource_Filename{
+			Filename: path,
+		},
+		WatchedDirectory: &envoy_core_v3.WatchedDirectory{
+			Path: filepath.Dir(path),
+		},
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"fmt"
+	"strconv"
+
+	envoy_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	http "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	"github.com/projectcontour/contour/internal/dag"
+	"github.com/projectcontour/contour/internal/protobuf"
+)
+
+// Tracing builds the HTTP connection manager `tracing` field for the
+// listener filter chain fronting proxy traffic, from the DAG's global
+// TracingConfig. It returns nil if tracing is not configured, in which
+// case the caller must omit the field from the HttpConnectionManager
+// so Envoy does not emit spans.
+func Tracing(config *dag.TracingConfig) *http.HttpConnectionManager_Tracing {
+	if config == nil {
+		return nil
+	}
+
+	tracing := &http.HttpConnectionManager_Tracing{
+		Provider:   tracingProvider(config),
+		CustomTags: customTags(config.CustomTags),
+	}
+
+	if config.MaxPathTagLength > 0 {
+		tracing.MaxPathTagLength = &wrappers.UInt32Value{Value: config.MaxPathTagLength}
+	}
+
+	if percent, ok := parseSamplingPercent(config.OverallSampling); ok {
+		tracing.OverallSampling = &envoy_trace_v3.Percent{Value: percent}
+	}
+
+	return tracing
+}
+
+// ApplyRoutePolicy overrides the sampling rate, max path tag length,
+// and custom tags on a listener-level tracing config with a route's
+// TracingPolicy, returning a new value so the listener-level default
+// is left untouched for other routes.
+func ApplyRoutePolicy(base *http.HttpConnectionManager_Tracing, policy *dag.TracingPolicy) *http.HttpConnectionManager_Tracing {
+	if base == nil || policy == nil {
+		return base
+	}
+
+	merged := *base
+
+	if percent, ok := parseSamplingPercent(policy.OverallSampling); ok {
+		merged.OverallSampling = &envoy_trace_v3.Percent{Value: percent}
+	}
+
+	if policy.MaxPathTagLength > 0 {
+		merged.MaxPathTagLength = &wrappers.UInt32Value{Value: policy.MaxPathTagLength}
+	}
+
+	if len(policy.CustomTags) > 0 {
+		merged.CustomTags = append(append([]*envoy_trace_v3.CustomTag{}, base.CustomTags...), customTags(policy.CustomTags)...)
+	}
+
+	return &merged
+}
+
+// tracingProvider builds the Envoy tracer provider config for the
+// configured backend. OpenTelemetry is the default; Zipkin and Datadog
+// are used when explicitly requested. An unrecognized provider panics
+// rather than silently wiring up a tracer the collector can't speak
+// to -- the xDS panic-recovery interceptor turns that into a rejected
+// xDS response instead of a crashed server, and the root cause (a
+// ContourConfiguration with a bogus Provider value) should never pass
+// whatever validates it ahead of the DAG/xDS translator.
+func tracingProvider(config *dag.TracingConfig) *envoy_trace_v3.Tracing_Http {
+	clusterName := ExtensionClusterName(config.ExtensionServiceNamespace, config.ExtensionServiceName)
+
+	switch config.Provider {
+	case contour_api_v1alpha1.ZipkinTracingProvider:
+		return &envoy_trace_v3.Tracing_Http{
+			Name: "envoy.tracers.zipkin",
+			ConfigType: &envoy_trace_v3.Tracing_Http_TypedConfig{
+				TypedConfig: protobuf.MustMarshalAny(&envoy_trace_v3.ZipkinConfig{
+					CollectorCluster:         clusterName,
+					CollectorEndpoint:        "/api/v2/spans",
+					CollectorEndpointVersion: envoy_trace_v3.ZipkinConfig_HTTP_JSON,
+				}),
+			},
+		}
+	case contour_api_v1alpha1.DatadogTracingProvider:
+		return &envoy_trace_v3.Tracing_Http{
+			Name: "envoy.tracers.datadog",
+			ConfigType: &envoy_trace_v3.Tracing_Http_TypedConfig{
+				TypedConfig: protobuf.MustMarshalAny(&envoy_trace_v3.DatadogConfig{
+					CollectorCluster: clusterName,
+					ServiceName:      config.ServiceName,
+				}),
+			},
+		}
+	case contour_api_v1alpha1.OpenTelemetryTracingProvider, "":
+		return openTelemetryProvider(config, clusterName)
+	default:
+		panic(fmt.Sprintf("unsupported tracing provider %q", config.Provider))
+	}
+}
+
+func openTelemetryProvider(config *dag.TracingConfig, clusterName string) *envoy_trace_v3.Tracing_Http {
+	return &envoy_trace_v3.Tracing_Http{
+		Name: "envoy.tracers.opentelemetry",
+		ConfigType: &envoy_trace_v3.Tracing_Http_TypedConfig{
+			TypedConfig: protobuf.MustMarshalAny(&envoy_trace_v3.OpenTelemetryConfig{
+				GrpcService: &envoy_trace_v3.OpenTelemetryConfig_GrpcService{
+					EnvoyGrpc: &envoy_trace_v3.OpenTelemetryConfig_GrpcService_EnvoyGrpc{
+						ClusterName: clusterName,
+					},
+				},
+				ServiceName: config.ServiceName,
+			}),
+		},
+	}
+}
+
+func customTags(tags []dag.CustomTag) []*envoy_trace_v3.CustomTag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	out := make([]*envoy_trace_v3.CustomTag, 0, len(tags))
+	for _, t := range tags {
+		tag := &envoy_trace_v3.CustomTag{Tag: t.TagName}
+
+		if t.RequestHeaderName != "" {
+			tag.Type = &envoy_trace_v3.CustomTag_RequestHeader{
+				RequestHeader: &envoy_trace_v3.CustomTag_Header{
+					Name: t.RequestHeaderName,
+				},
+			}
+		} else {
+			tag.Type = &envoy_trace_v3.CustomTag_Literal_{
+				Literal: &envoy_trace_v3.CustomTag_Literal{
+					Value: t.Literal,
+				},
+			}
+		}
+
+		out = append(out, tag)
+	}
+
+	return out
+}
+
+// parseSamplingPercent parses a tracing sampling percentage string
+// (e.g. "100", "0.1") into the float64 Envoy's Percent proto expects.
+// It returns ok=false for an empty or unparseable value, leaving the
+// caller's existing sampling rate untouched.
+func parseSamplingPercent(s string) (float64, bool) {
+	if s == "" {
+		return 0, false
+	}
+
+	percent, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return percent, true
+}
@@ -0,0 +1,30 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RefreshPollTotal counts poll ticks run by internal/refresh.Poller,
+// by integration (e.g. "certificate", "ratelimit_config"), regardless
+// of whether the poll found a change or succeeded.
+var RefreshPollTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "contour_refresh_poll_total",
+	Help: "Total number of refresh polls run for an external integration, by integration type.",
+}, []string{"integration"})
+
+func init() {
+	prometheus.MustRegister(RefreshPollTotal)
+}
@@ -0,0 +1,32 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// XDSPanicTotal counts panics recovered from while generating xDS
+// resources, broken down by the resource type (e.g. "cluster",
+// "listener") being generated when the panic occurred. A nonzero
+// reading here means a single malformed input was prevented from
+// taking down the xDS server for every Envoy.
+var XDSPanicTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "contour_xds_panic_total",
+	Help: "Total number of panics recovered from while generating xDS resources, by resource type.",
+}, []string{"resource_type"})
+
+func init() {
+	prometheus.MustRegister(XDSPanicTotal)
+}
@@ -0,0 +1,102 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package refresh runs the background pollers that keep Contour in
+// sync with external integrations (cert-manager Certificates,
+// ExtensionService health, rate limit descriptor config) that aren't
+// otherwise watched via a Kubernetes informer.
+package refresh
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	"github.com/projectcontour/contour/internal/metrics"
+)
+
+const (
+	// MinInterval is the lowest poll interval Contour honors for any
+	// RefreshPolicy integration, regardless of user configuration.
+	// Unlike a load balancer health check against a remote endpoint,
+	// these polls hit the Kubernetes API server or a local extension
+	// service, so a floor this low doesn't risk overloading anything,
+	// but it still guards against a zero or negative interval spinning
+	// the poll loop.
+	MinInterval = 1 * time.Second
+
+	// MaxInterval is the highest poll interval Contour honors. Past
+	// this, a genuinely stale certificate or rate limit config could
+	// go undetected for an operationally unreasonable length of time.
+	MaxInterval = 24 * time.Hour
+)
+
+// ClampInterval clamps d into [MinInterval, MaxInterval], logging a
+// warning naming integration and the original value when it has to.
+// A zero d (the field wasn't set) is treated as "use the default" and
+// returned unchanged so callers can fall back to their own default.
+func ClampInterval(integration string, d time.Duration) time.Duration {
+	if d == 0 {
+		return 0
+	}
+
+	switch {
+	case d < MinInterval:
+		klog.Warningf("%s refresh interval %s is below the minimum of %s; clamping", integration, d, MinInterval)
+		return MinInterval
+	case d > MaxInterval:
+		klog.Warningf("%s refresh interval %s is above the maximum of %s; clamping", integration, d, MaxInterval)
+		return MaxInterval
+	default:
+		return d
+	}
+}
+
+// Interval resolves a RefreshInterval's Min into a clamped, concrete
+// poll interval, falling back to defaultInterval if Min is unset.
+func Interval(integration string, ri *contour_api_v1alpha1.RefreshInterval, defaultInterval time.Duration) time.Duration {
+	if ri == nil || ri.Min.Duration == 0 {
+		return defaultInterval
+	}
+
+	if clamped := ClampInterval(integration, ri.Min.Duration); clamped != 0 {
+		return clamped
+	}
+
+	return defaultInterval
+}
+
+// Poller runs fn every interval until ctx is canceled, incrementing
+// the contour_refresh_poll_total counter for integration on every
+// tick, whether or not fn returns an error, so the counter reflects
+// "a poll happened" rather than "a poll succeeded" -- operators alert
+// on the absence of polls, and inspect logs for poll failures
+// separately.
+func Poller(ctx context.Context, integration string, interval time.Duration, fn func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			metrics.RefreshPollTotal.WithLabelValues(integration).Inc()
+			if err := fn(ctx); err != nil {
+				klog.Errorf("%s refresh poll failed: %v", integration, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registry implements a background poller that resolves
+// HTTPProxy services backed by a ServiceRegistry against an external
+// service catalog (Consul, Nomad, or a generic HTTP endpoint), feeding
+// the resulting endpoints into the DAG as if they came from a
+// Kubernetes Endpoints/EndpointSlice informer.
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	"github.com/projectcontour/contour/internal/refresh"
+)
+
+// Endpoint is a single resolved backend returned by the catalog.
+type Endpoint struct {
+	Address string   `json:"address"`
+	Port    int      `json:"port"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// Poller periodically resolves a single Service name against a
+// ServiceRegistry's external catalog, caching the result for the
+// registry's configured TTL so bursts of DAG rebuilds between polls
+// don't each re-fetch from the catalog.
+type Poller struct {
+	client *http.Client
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	endpoints []Endpoint
+	expiresAt time.Time
+}
+
+// NewPoller returns a Poller using the given HTTP client, or
+// http.DefaultClient if client is nil.
+func NewPoller(client *http.Client) *Poller {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Poller{
+		client:  client,
+		entries: map[string]cacheEntry{},
+	}
+}
+
+// Resolve returns the endpoints for serviceName as seen by registry,
+// serving a cached response if one is present and not past its TTL,
+// and otherwise issuing a GET against registry.Spec.Endpoint.
+func (p *Poller) Resolve(ctx context.Context, registry *contour_api_v1alpha1.ServiceRegistry, serviceName string) ([]Endpoint, error) {
+	key := registry.Namespace + "/" + registry.Name + "/" + serviceName
+
+	if cached, ok := p.cached(key); ok {
+		return cached, nil
+	}
+
+	endpoints, err := p.fetch(ctx, registry, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	p.store(key, endpoints, ttlFor(registry))
+
+	return endpoints, nil
+}
+
+func (p *Poller) cached(key string) ([]Endpoint, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	entry, ok := p.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.endpoints, true
+}
+
+func (p *Poller) store(key string, endpoints []Endpoint, ttl time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.entries[key] = cacheEntry{
+		endpoints: endpoints,
+		expiresAt: time.Now().Add(ttl),
+	}
+}
+
+func (p *Poller) fetch(ctx context.Context, registry *contour_api_v1alpha1.ServiceRegistry, serviceName string) ([]Endpoint, error) {
+	u, err := url.Parse(registry.Spec.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ServiceRegistry endpoint %q: %w", registry.Spec.Endpoint, err)
+	}
+	u.Path = pathJoin(u.Path, serviceName)
+
+	q := u.Query()
+	if registry.Spec.RequireConsistent {
+		q.Set("consistent", "true")
+	}
+	if registry.Spec.Stale {
+		q.Set("stale", "true")
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("polling ServiceRegistry %s/%s for service %q: %w", registry.Namespace, registry.Name, serviceName, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("polling ServiceRegistry %s/%s for service %q: unexpected status %d", registry.Namespace, registry.Name, serviceName, resp.StatusCode)
+	}
+
+	var endpoints []Endpoint
+	if err := json.NewDecoder(resp.Body).Decode(&endpoints); err != nil {
+		return nil, fmt.Errorf("decoding ServiceRegistry %s/%s response for service %q: %w", registry.Namespace, registry.Name, serviceName, err)
+	}
+
+	return endpoints, nil
+}
+
+func ttlFor(registry *contour_api_v1alpha1.ServiceRegistry) time.Duration {
+	if registry.Spec.Cache != nil && registry.Spec.Cache.TTL.Duration > 0 {
+		return registry.Spec.Cache.TTL.Duration
+	}
+
+	if registry.Spec.RefreshInterval.Duration > 0 {
+		return registry.Spec.RefreshInterval.Duration
+	}
+
+	return 30 * time.Second
+}
+
+// Watch starts a background poll loop that resolves serviceName
+// against registry every interval, invoking onChange with the new
+// endpoint set whenever it differs from what the previous poll saw.
+// This is what lets catalog-only endpoint churn -- with no
+// accompanying Kubernetes object change -- reach the DAG: the caller
+// wires onChange to trigger a rebuild/resync. Watch blocks until ctx
+// is canceled, so callers run it in its own goroutine per registry and
+// service name.
+func (p *Poller) Watch(ctx context.Context, registry *contour_api_v1alpha1.ServiceRegistry, serviceName string, interval time.Duration, onChange func([]Endpoint)) {
+	key := registry.Namespace + "/" + registry.Name + "/" + serviceName
+
+	var last []Endpoint
+	var haveLast bool
+
+	refresh.Poller(ctx, "service_registry", interval, func(ctx context.Context) error {
+		endpoints, err := p.fetch(ctx, registry, serviceName)
+		if err != nil {
+			return err
+		}
+
+		p.store(key, endpoints, ttlFor(registry))
+
+		if !haveLast || !reflect.DeepEqual(last, endpoints) {
+			last = endpoints
+			haveLast = true
+			onChange(endpoints)
+		}
+
+		return nil
+	})
+}
+
+func pathJoin(base, name string) string {
+	if len(base) > 0 && base[len(base)-1] == '/' {
+		return base + name
+	}
+	return base + "/" + name
+}
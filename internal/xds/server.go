@@ -0,0 +1,125 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xds hosts the gRPC server Envoy connects to for xDS
+// discovery requests.
+package xds
+
+import (
+	"context"
+	"path"
+	"runtime/debug"
+	"strings"
+
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+
+	"github.com/projectcontour/contour/internal/metrics"
+)
+
+// resourceTypeFromMethod derives the xDS resource type label used for
+// the contour_xds_panic_total metric from the gRPC method name Envoy
+// called, e.g. "/envoy.service.cluster.v3.ClusterDiscoveryService/
+// StreamClusters" becomes "cluster". It returns "unknown" for methods
+// that don't follow the discovery service naming convention, rather
+// than panicking on an unexpected input.
+func resourceTypeFromMethod(fullMethod string) string {
+	// fullMethod looks like
+	// "/envoy.service.cluster.v3.ClusterDiscoveryService/StreamClusters":
+	// the gRPC service name is dot-separated, not slash-separated, so
+	// path.Base alone can't isolate "ClusterDiscoveryService" from it --
+	// split on "." first to get the last segment of the service name.
+	service := path.Base(path.Dir(fullMethod))
+
+	name := service
+	if idx := strings.LastIndexByte(service, '.'); idx >= 0 {
+		name = service[idx+1:]
+	}
+
+	const suffix = "DiscoveryService"
+	if len(name) <= len(suffix) || name[len(name)-len(suffix):] != suffix {
+		return "unknown"
+	}
+
+	return strings.ToLower(name[:len(name)-len(suffix)])
+}
+
+// recoveryHandler logs the panic and the xDS resource type being
+// generated when it occurred, increments contour_xds_panic_total, and
+// converts the panic to a codes.Internal gRPC status so the stream for
+// this Envoy is torn down without the process crashing and every other
+// connected Envoy losing its connection too.
+func recoveryHandler(resourceType string) grpc_recovery.RecoveryHandlerFuncContext {
+	return func(ctx context.Context, p interface{}) error {
+		klog.Errorf("recovered from panic generating %s xDS resources: %v\n%s", resourceType, p, debug.Stack())
+		metrics.XDSPanicTotal.WithLabelValues(resourceType).Inc()
+		return status.Errorf(codes.Internal, "internal error generating %s resources", resourceType)
+	}
+}
+
+// resourceTypeUnaryInterceptor and resourceTypeStreamInterceptor tag
+// the context with the xDS resource type implied by the called
+// method, so the recovery interceptor installed after them can label
+// the panic metric without needing to understand the xDS resource
+// model itself.
+type resourceTypeKey struct{}
+
+func resourceTypeUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx = context.WithValue(ctx, resourceTypeKey{}, resourceTypeFromMethod(info.FullMethod))
+	return handler(ctx, req)
+}
+
+type recoveryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *recoveryServerStream) Context() context.Context { return s.ctx }
+
+func resourceTypeStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := context.WithValue(ss.Context(), resourceTypeKey{}, resourceTypeFromMethod(info.FullMethod))
+	return handler(srv, &recoveryServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(resourceTypeKey{}).(string); ok {
+		return v
+	}
+	return "unknown"
+}
+
+// ServerOptions returns the gRPC server options Contour's xDS server
+// is built with: interceptors that recover from a panic while
+// generating resources for a single Envoy/resource type, rather than
+// letting it bring down the whole process and disconnect every Envoy.
+func ServerOptions() []grpc.ServerOption {
+	recoveryOpt := grpc_recovery.WithRecoveryHandlerContext(
+		func(ctx context.Context, p interface{}) error {
+			return recoveryHandler(resourceTypeFromContext(ctx))(ctx, p)
+		},
+	)
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			resourceTypeUnaryInterceptor,
+			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
+		),
+		grpc.ChainStreamInterceptor(
+			resourceTypeStreamInterceptor,
+			grpc_recovery.StreamServerInterceptor(recoveryOpt),
+		),
+	}
+}
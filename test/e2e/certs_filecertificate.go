@@ -0,0 +1,31 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import "crypto/tls"
+
+// IssuedBy reports whether the leaf certificate Envoy presented in
+// state was issued for commonName, letting tests confirm which of two
+// certificates sharing a SAN Envoy is actually serving after a
+// rotation or fallback.
+func (c *Certs) IssuedBy(state *tls.ConnectionState, commonName string) bool {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return false
+	}
+
+	return state.PeerCertificates[0].Subject.CommonName == commonName
+}
@@ -0,0 +1,64 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// parseCertificate decodes the leaf certificate out of a PEM-encoded
+// tls.crt value, as stored by cert-manager in a Kubernetes TLS
+// Secret.
+func parseCertificate(pemData []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// SerialNumber returns the serial number (in Secret.Data's
+// "tls.crt", as materialized by cert-manager) of the certificate
+// currently stored in the Secret named secretName, or "" if it can't
+// be read -- used to detect when cert-manager has reissued a
+// certificate, independent of whether Envoy has picked up the change
+// yet.
+func (c *Certs) SerialNumber(namespace, secretName string) string {
+	cl, err := newClient()
+	if err != nil {
+		return ""
+	}
+
+	var secret corev1.Secret
+	if err := cl.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: secretName}, &secret); err != nil {
+		return ""
+	}
+
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return ""
+	}
+
+	return cert.SerialNumber.String()
+}
@@ -0,0 +1,52 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"crypto/tls"
+	"fmt"
+)
+
+// NegotiatedTLSVersion dials host over TLS, with the client offering
+// at most maxVersion (e.g. tls.VersionTLS12), and returns the protocol
+// version the server actually negotiated (e.g. "TLSv1.3"), or "" if
+// the handshake fails -- which happens when maxVersion is below the
+// vhost's minimum TLS version.
+func (c *Certs) NegotiatedTLSVersion(namespace, host string, maxVersion uint16) string {
+	conn, err := tls.Dial("tcp", fmt.Sprintf("%s:443", host), &tls.Config{
+		InsecureSkipVerify: true, //nolint:gosec // test-only: we only care about the negotiated version, not the cert chain.
+		ServerName:         host,
+		MaxVersion:         maxVersion,
+	})
+	if err != nil {
+		return ""
+	}
+	defer conn.Close()
+
+	switch conn.ConnectionState().Version {
+	case tls.VersionTLS13:
+		return "TLSv1.3"
+	case tls.VersionTLS12:
+		return "TLSv1.2"
+	case tls.VersionTLS11:
+		return "TLSv1.1"
+	case tls.VersionTLS10:
+		return "TLSv1.0"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EnsureFileCertificateResources copies the TLS Secret certSecretName
+// (as created by Certs.CreateSelfSignedCert) into the well-known
+// Secret Contour's local FileCertificate source watches on disk for
+// FileCertificate{Name: name}, so a HTTPProxy referencing it has
+// something to serve.
+func (d *Deployment) EnsureFileCertificateResources(namespace, name, certSecretName string) error {
+	cl, err := newClient()
+	if err != nil {
+		return fmt.Errorf("building client for file certificate resources: %w", err)
+	}
+
+	ctx := context.Background()
+
+	var src corev1.Secret
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: certSecretName}, &src); err != nil {
+		return fmt.Errorf("fetching cert secret %s/%s: %w", namespace, certSecretName, err)
+	}
+
+	dst := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      fileCertificateSecretName(name),
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: src.Data,
+	}
+
+	if err := cl.Create(ctx, dst); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating file-certificate secret for %q: %w", name, err)
+		}
+
+		return d.RotateFileCertificateResources(namespace, name, certSecretName)
+	}
+
+	return nil
+}
+
+// RotateFileCertificateResources replaces the file-mounted certificate
+// data for name with the contents of certSecretName, simulating an
+// operator rotating the certificate files on disk that Contour's
+// file-watched SDS source picks up without an Envoy restart.
+func (d *Deployment) RotateFileCertificateResources(namespace, name, certSecretName string) error {
+	cl, err := newClient()
+	if err != nil {
+		return fmt.Errorf("building client for file certificate resources: %w", err)
+	}
+
+	ctx := context.Background()
+
+	var src corev1.Secret
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: certSecretName}, &src); err != nil {
+		return fmt.Errorf("fetching cert secret %s/%s: %w", namespace, certSecretName, err)
+	}
+
+	var dst corev1.Secret
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: fileCertificateSecretName(name)}, &dst); err != nil {
+		return fmt.Errorf("fetching file-certificate secret for %q: %w", name, err)
+	}
+
+	dst.Data = src.Data
+	if err := cl.Update(ctx, &dst); err != nil {
+		return fmt.Errorf("rotating file-certificate secret for %q: %w", name, err)
+	}
+
+	return nil
+}
+
+func fileCertificateSecretName(name string) string {
+	return "file-cert-" + name
+}
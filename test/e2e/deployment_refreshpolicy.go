@@ -0,0 +1,95 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// EnvoyLoadedSecretSerial returns the serial number of the certificate
+// Envoy currently has loaded for the SDS secret named secretName, by
+// querying the admin interface's /certs endpoint. It returns "" if the
+// secret isn't loaded yet or the admin interface can't be reached.
+func (d *Deployment) EnvoyLoadedSecretSerial(namespace, secretName string) string {
+	resp, err := http.Get(fmt.Sprintf("http://%s/certs?format=json", envoyAdminAddr))
+	if err != nil {
+		return ""
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Certificates []struct {
+			CaCert []struct {
+				Path         string `json:"path"`
+				SerialNumber string `json:"serial_number"`
+			} `json:"ca_cert"`
+			CertChainCerts []struct {
+				Path         string `json:"path"`
+				SerialNumber string `json:"serial_number"`
+			} `json:"cert_chain_certs"`
+		} `json:"certificates"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return ""
+	}
+
+	for _, cert := range out.Certificates {
+		for _, chain := range cert.CertChainCerts {
+			if pathContainsSecret(chain.Path, namespace, secretName) {
+				return chain.SerialNumber
+			}
+		}
+	}
+
+	return ""
+}
+
+func pathContainsSecret(path, namespace, secretName string) bool {
+	return path != "" && (fmt.Sprintf("%s/%s", namespace, secretName) == path ||
+		fmt.Sprintf("%s_%s", namespace, secretName) == path ||
+		fmt.Sprintf("%s-%s", namespace, secretName) == path)
+}
+
+// RateLimitServiceDescriptors returns the raw descriptor config the
+// rate limit service in namespace currently has active, by querying
+// its debug config-dump endpoint, so a test can confirm a ConfigMap
+// edit was actually reloaded rather than just polled and discarded.
+func (d *Deployment) RateLimitServiceDescriptors(namespace string) (string, error) {
+	resp, err := http.Get(fmt.Sprintf("http://%s/rlconfig", rateLimitAdminAddr))
+	if err != nil {
+		return "", fmt.Errorf("querying rate limit service config for %q: %w", namespace, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("querying rate limit service config for %q: unexpected status %d", namespace, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading rate limit service config for %q: %w", namespace, err)
+	}
+
+	return string(body), nil
+}
+
+// rateLimitAdminAddr is where the rate limit service's debug endpoint
+// is reachable from the test process.
+const rateLimitAdminAddr = "127.0.0.1:6070"
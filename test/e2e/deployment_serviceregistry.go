@@ -0,0 +1,72 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// envoyAdminAddr is where the Envoy DaemonSet's admin interface is
+// reachable from the test process.
+const envoyAdminAddr = "127.0.0.1:9001"
+
+// EnvoyClusterEndpointCount returns the number of healthy endpoints
+// Envoy currently has loaded for clusterName, by querying the admin
+// interface's /clusters endpoint in JSON form. It returns -1 if the
+// cluster isn't present yet or the admin interface can't be reached,
+// so a require.Eventually poll naturally keeps retrying instead of
+// failing outright on a cluster that hasn't been programmed yet.
+func (d *Deployment) EnvoyClusterEndpointCount(namespace, clusterName string) int {
+	resp, err := http.Get(fmt.Sprintf("http://%s/clusters?format=json", envoyAdminAddr))
+	if err != nil {
+		return -1
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		ClusterStatuses []struct {
+			Name         string `json:"name"`
+			HostStatuses []struct {
+				HealthStatus struct {
+					EdsHealthStatus string `json:"eds_health_status"`
+				} `json:"health_status"`
+			} `json:"host_statuses"`
+		} `json:"cluster_statuses"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return -1
+	}
+
+	for _, cs := range out.ClusterStatuses {
+		if cs.Name != clusterName {
+			continue
+		}
+
+		count := 0
+		for _, h := range cs.HostStatuses {
+			if h.HealthStatus.EdsHealthStatus != "UNHEALTHY" {
+				count++
+			}
+		}
+
+		return count
+	}
+
+	return -1
+}
@@ -0,0 +1,30 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+// EnsureTracingResources deploys the fake tracing collector named name
+// into namespace via DeployTracingCollector and waits for Envoy to
+// pick up the resulting ExtensionService cluster, so a
+// ContourConfiguration's Tracing.ExtensionService can reference name
+// as soon as this returns.
+func (d *Deployment) EnsureTracingResources(namespace, name string) error {
+	if _, err := DeployTracingCollector(namespace, name); err != nil {
+		return err
+	}
+
+	return d.WaitForEnvoyDaemonSetUpdated()
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
 
@@ -225,9 +226,50 @@ var _ = Describe("HTTPProxy", func() {
 					Name:      "backend-client-cert",
 					Namespace: namespace,
 				}
+
+				// Use a short interval so the refresh assertion below
+				// doesn't have to wait out the production-recommended
+				// 10m-24h range.
+				contourConfiguration.Spec.RefreshPolicy = &contour_api_v1alpha1.RefreshPolicy{
+					Certificate: &contour_api_v1alpha1.RefreshInterval{
+						Min: metav1.Duration{Duration: 5 * time.Second},
+						Max: metav1.Duration{Duration: 30 * time.Second},
+					},
+				}
 			})
 
 			testBackendTLS(namespace)
+
+			Specify("a cert-manager-issued backend client certificate swap is picked up within the configured refresh interval", func() {
+				testBackendTLSCertificateRefresh(namespace)
+			})
+		})
+	})
+
+	f.NamespacedTest("httpproxy-service-registry", testServiceRegistry)
+
+	f.NamespacedTest("httpproxy-xds-panic-recovery", testXDSPanicRecovery)
+
+	f.NamespacedTest("httpproxy-tenant-profile-inheritance", testTenantProfileInheritance)
+
+	f.NamespacedTest("httpproxy-file-certificate", func(namespace string) {
+		Context("with a fallback certificate also configured", func() {
+			BeforeEach(func() {
+				contourConfig.TLS = config.TLSParameters{
+					FallbackCertificate: config.NamespacedName{
+						Name:      "fallback-cert",
+						Namespace: namespace,
+					},
+				}
+				contourConfiguration.Spec.HTTPProxy.FallbackCertificate = &contour_api_v1alpha1.NamespacedName{
+					Name:      "fallback-cert",
+					Namespace: namespace,
+				}
+
+				f.Certs.CreateSelfSignedCert(namespace, "fallback-cert", "fallback-cert", "fallback.projectcontour.io")
+			})
+
+			testFileCertificateSource(namespace)
 		})
 	})
 
@@ -268,6 +310,25 @@ var _ = Describe("HTTPProxy", func() {
 			testExternalNameServiceLocalhostInvalid(namespace)
 		})
 	})
+	f.NamespacedTest("httpproxy-tracing", func(namespace string) {
+		Context("with tracing configured", func() {
+			BeforeEach(func() {
+				contourConfiguration.Spec.Tracing = &contour_api_v1alpha1.TracingConfig{
+					ExtensionService: &contour_api_v1alpha1.NamespacedName{
+						Name:      e2e.TracingExtensionServiceName,
+						Namespace: namespace,
+					},
+					ServiceName:      "contour",
+					MaxPathTagLength: 256,
+					OverallSampling:  "100",
+				}
+				require.NoError(f.T(), f.Deployment.EnsureTracingResources(namespace, e2e.TracingExtensionServiceName))
+			})
+
+			testTracing(namespace)
+		})
+	})
+
 	f.NamespacedTest("httpproxy-local-rate-limiting-vhost", testLocalRateLimitingVirtualHost)
 
 	f.NamespacedTest("httpproxy-local-rate-limiting-route", testLocalRateLimitingRoute)
@@ -291,6 +352,15 @@ var _ = Describe("HTTPProxy", func() {
 							FailOpen:                false,
 							EnableXRateLimitHeaders: false,
 						}
+						// Use a short interval so the reload assertion below
+						// doesn't have to wait out the production-recommended
+						// 10m-24h range.
+						contourConfiguration.Spec.RefreshPolicy = &contour_api_v1alpha1.RefreshPolicy{
+							RateLimitConfig: &contour_api_v1alpha1.RefreshInterval{
+								Min: metav1.Duration{Duration: 5 * time.Second},
+								Max: metav1.Duration{Duration: 30 * time.Second},
+							},
+						}
 						require.NoError(f.T(),
 							f.Deployment.EnsureRateLimitResources(
 								namespace,
@@ -331,6 +401,8 @@ descriptors:
 		f.NamespacedTest("httpproxy-global-rate-limiting-vhost-tls", withRateLimitService(testGlobalRateLimitingVirtualHostTLS))
 
 		f.NamespacedTest("httpproxy-global-rate-limiting-route-tls", withRateLimitService(testGlobalRateLimitingRouteTLS))
+
+		f.NamespacedTest("httpproxy-global-rate-limiting-config-refresh", withRateLimitService(testRateLimitConfigRefresh))
 	})
 })
 
@@ -0,0 +1,90 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package httpproxy
+
+import (
+	"context"
+	"strings"
+
+	certmanagerv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/projectcontour/contour/test/e2e"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// testBackendTLSCertificateRefresh forces cert-manager to reissue the
+// backend client certificate by changing its CommonName, and asserts
+// that Contour reprograms Envoy's SDS secret with the reissued
+// certificate within the configured certificate RefreshPolicy
+// interval, without requiring a pod restart. Asserting on the poll
+// counter alone would pass even if the poller fetched the new secret
+// and discarded it, so this compares the serial number Envoy actually
+// has loaded against the serial number cert-manager just issued.
+func testBackendTLSCertificateRefresh(namespace string) {
+	t := f.T()
+
+	before := f.Certs.SerialNumber(namespace, "backend-client-cert")
+
+	cert := &certmanagerv1.Certificate{}
+	require.NoError(t, f.Client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "backend-client-cert"}, cert))
+
+	cert.Spec.CommonName = "client-rotated"
+	require.NoError(t, f.Client.Update(context.TODO(), cert))
+
+	require.Eventually(t, func() bool {
+		after := f.Certs.SerialNumber(namespace, "backend-client-cert")
+		if after == before {
+			// cert-manager hasn't reissued yet.
+			return false
+		}
+
+		loaded := f.Deployment.EnvoyLoadedSecretSerial(namespace, "backend-client-cert")
+		return loaded == after
+	}, e2e.DefaultEventuallyTimeout, e2e.DefaultEventuallyPollingInterval,
+		"expected Envoy to load the reissued backend client certificate")
+}
+
+// testRateLimitConfigRefresh edits the rate limit service ConfigMap in
+// place and asserts that the rate limit service actually reloaded the
+// new descriptors within the configured RateLimitConfig RefreshPolicy
+// interval, without requiring a pod restart. Asserting on the poll
+// counter alone would pass even if the poller silently failed to apply
+// the new descriptors, so this reads the descriptor config the rate
+// limit service currently has active.
+func testRateLimitConfigRefresh(namespace string) {
+	t := f.T()
+
+	const updated = `
+domain: contour
+descriptors:
+  - key: generic_key
+    value: vhostlimit
+    rate_limit:
+      unit: hour
+      requests_per_unit: 2`
+
+	require.NoError(t, f.Deployment.EnsureRateLimitResources(namespace, updated))
+
+	require.Eventually(t, func() bool {
+		active, err := f.Deployment.RateLimitServiceDescriptors(namespace)
+		if err != nil {
+			return false
+		}
+		return strings.Contains(active, "requests_per_unit: 2")
+	}, e2e.DefaultEventuallyTimeout, e2e.DefaultEventuallyPollingInterval,
+		"expected the rate limit service to reload the updated descriptor config")
+}
@@ -0,0 +1,107 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package httpproxy
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	"github.com/projectcontour/contour/test/e2e"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testServiceRegistry exercises a HTTPProxy whose route points at a
+// service backed by a ServiceRegistry rather than a Kubernetes Service,
+// using a fake catalog HTTP server started by the test fixture. It
+// asserts that routing succeeds against the initial catalog response
+// and that endpoint churn (an address added to the catalog) is reflected
+// in the Envoy EDS cluster within the configured refresh interval.
+func testServiceRegistry(namespace string) {
+	Specify("a HTTPProxy can route to a ServiceRegistry-backed service", func() {
+		t := f.T()
+
+		catalog, err := e2e.DeployServiceCatalog(namespace, "catalog")
+		require.NoError(t, err)
+		require.NoError(t, catalog.SetEndpoints("catalog-backend", []e2e.CatalogEndpoint{
+			{Address: catalog.EchoAddress(namespace, "echo-a"), Port: 80},
+		}))
+
+		registry := &contour_api_v1alpha1.ServiceRegistry{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "catalog-registry",
+			},
+			Spec: contour_api_v1alpha1.ServiceRegistrySpec{
+				Endpoint:          catalog.URL(),
+				RefreshInterval:   metav1.Duration{Duration: e2e.CatalogRefreshInterval},
+				RequireConsistent: false,
+				Stale:             true,
+				Cache: &contour_api_v1alpha1.ServiceRegistryCache{
+					TTL: metav1.Duration{Duration: e2e.CatalogRefreshInterval},
+				},
+			},
+		}
+		require.NoError(t, f.Client.Create(context.TODO(), registry))
+
+		p := &contourv1.HTTPProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "service-registry",
+			},
+			Spec: contourv1.HTTPProxySpec{
+				VirtualHost: &contourv1.VirtualHost{
+					Fqdn: "service-registry.projectcontour.io",
+				},
+				Routes: []contourv1.Route{
+					{
+						Services: []contourv1.Service{
+							{
+								Name: "catalog-backend",
+								Port: 80,
+								Registry: &contourv1.ServiceRegistryRef{
+									Name: registry.Name,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		f.CreateHTTPProxyAndWaitFor(p, httpProxyValid)
+
+		res, ok := f.HTTP.RequestUntil(&e2e.HTTPRequestOpts{
+			Host:      p.Spec.VirtualHost.Fqdn,
+			Path:      "/",
+			Condition: e2e.HasStatusCode(200),
+		})
+		require.True(t, ok, "expected 200 response code, got %d", res.StatusCode)
+
+		// Add a second endpoint to the catalog and confirm Contour
+		// picks up the churn within the configured refresh interval.
+		require.NoError(t, catalog.SetEndpoints("catalog-backend", []e2e.CatalogEndpoint{
+			{Address: catalog.EchoAddress(namespace, "echo-a"), Port: 80},
+			{Address: catalog.EchoAddress(namespace, "echo-b"), Port: 80},
+		}))
+
+		require.Eventually(t, func() bool {
+			return f.Deployment.EnvoyClusterEndpointCount(namespace, "service-registry/catalog-backend") == 2
+		}, e2e.DefaultEventuallyTimeout, e2e.DefaultEventuallyPollingInterval, "expected cluster to reflect catalog endpoint churn")
+	})
+}
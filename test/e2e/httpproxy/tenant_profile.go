@@ -0,0 +1,112 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package httpproxy
+
+import (
+	"context"
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo"
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	contour_api_v1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	"github.com/projectcontour/contour/test/e2e"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testTenantProfileInheritance exercises a TenantProfile bound to a
+// namespace, asserting that the HTTPProxies in that namespace inherit
+// its defaults (here, a TLS minimum protocol version), that a HTTPProxy
+// can override an inherited default, and that deleting the TenantProfile
+// reverts HTTPProxies back to the cluster-wide defaults.
+func testTenantProfileInheritance(namespace string) {
+	Specify("HTTPProxies inherit policy from the TenantProfile bound to their namespace", func() {
+		t := f.T()
+
+		f.Fixtures.Echo.Deploy(namespace, "echo")
+
+		f.Certs.CreateSelfSignedCert(namespace, "tenant-inherit-cert", "tenant-inherit-cert", "tenant-inherit.projectcontour.io")
+		f.Certs.CreateSelfSignedCert(namespace, "tenant-override-cert", "tenant-override-cert", "tenant-override.projectcontour.io")
+
+		tenant := &contour_api_v1alpha1.TenantProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "default-tenant",
+			},
+			Spec: contour_api_v1alpha1.TenantProfileSpec{
+				Namespaces:                []string{namespace},
+				TLSMinimumProtocolVersion: "1.3",
+			},
+		}
+		require.NoError(t, f.Client.Create(context.TODO(), tenant))
+
+		p := &contourv1.HTTPProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "tenant-inherit",
+			},
+			Spec: contourv1.HTTPProxySpec{
+				VirtualHost: &contourv1.VirtualHost{
+					Fqdn: "tenant-inherit.projectcontour.io",
+					TLS: &contourv1.TLS{
+						SecretName: "tenant-inherit-cert",
+					},
+				},
+				Routes: []contourv1.Route{
+					{
+						Services: []contourv1.Service{
+							{
+								Name: "echo",
+								Port: 80,
+							},
+						},
+					},
+				},
+			},
+		}
+		f.CreateHTTPProxyAndWaitFor(p, httpProxyValid)
+
+		res, ok := f.HTTP.SecureRequestUntil(&e2e.HTTPSRequestOpts{
+			Host:      p.Spec.VirtualHost.Fqdn,
+			Path:      "/",
+			Condition: e2e.HasStatusCode(200),
+		})
+		require.True(t, ok, "expected 200 response code, got %d", res.StatusCode)
+		require.Equal(t, "TLSv1.3", f.Certs.NegotiatedTLSVersion(namespace, "tenant-inherit.projectcontour.io", tls.VersionTLS13))
+
+		// A HTTPProxy can still override an inherited default. A
+		// minimum of 1.2 still allows 1.3, so the client is capped at
+		// 1.2 to observe the lowered minimum.
+		override := p.DeepCopy()
+		override.Name = "tenant-override"
+		override.Spec.VirtualHost.Fqdn = "tenant-override.projectcontour.io"
+		override.Spec.VirtualHost.TLS = &contourv1.TLS{
+			SecretName:             "tenant-override-cert",
+			MinimumProtocolVersion: "1.2",
+		}
+		f.CreateHTTPProxyAndWaitFor(override, httpProxyValid)
+		require.Equal(t, "TLSv1.2", f.Certs.NegotiatedTLSVersion(namespace, "tenant-override.projectcontour.io", tls.VersionTLS12))
+
+		// Deleting the TenantProfile should revoke its policy and fall
+		// back to the cluster-wide default, which accepts a client
+		// capped at 1.2.
+		require.NoError(t, f.Client.Delete(context.TODO(), tenant))
+		require.Eventually(t, func() bool {
+			return f.Certs.NegotiatedTLSVersion(namespace, "tenant-inherit.projectcontour.io", tls.VersionTLS12) == "TLSv1.2"
+		}, e2e.DefaultEventuallyTimeout, e2e.DefaultEventuallyPollingInterval, "expected tenant policy to be revoked")
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package httpproxy
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/projectcontour/contour/test/e2e"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testTracing exercises a HTTPProxy with a global tracing collector
+// configured on Contour and a per-route override of the sampling rate
+// and custom tags. It asserts that spans emitted by Envoy for requests
+// through the proxy land in the collector with the expected tags.
+func testTracing(namespace string) {
+	Specify("tracing spans can be collected for requests through a HTTPProxy", func() {
+		t := f.T()
+
+		f.Fixtures.Echo.Deploy(namespace, "echo")
+
+		collector := e2e.TracingCollectorAt(namespace, e2e.TracingExtensionServiceName)
+
+		p := &contourv1.HTTPProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "tracing",
+			},
+			Spec: contourv1.HTTPProxySpec{
+				VirtualHost: &contourv1.VirtualHost{
+					Fqdn: "tracing.projectcontour.io",
+				},
+				Routes: []contourv1.Route{
+					{
+						Services: []contourv1.Service{
+							{
+								Name: "echo",
+								Port: 80,
+							},
+						},
+						TracingPolicy: &contourv1.TracingPolicy{
+							OverallSampling: "100",
+							CustomTags: []contourv1.CustomTag{
+								{
+									TagName: "literal-tag",
+									Literal: "hello",
+								},
+								{
+									TagName: "header-tag",
+									RequestHeader: &contourv1.RequestHeaderCustomTag{
+										Name: "X-Tracing-Tag",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		f.CreateHTTPProxyAndWaitFor(p, httpProxyValid)
+
+		res, ok := f.HTTP.RequestUntil(&e2e.HTTPRequestOpts{
+			Host: p.Spec.VirtualHost.Fqdn,
+			Path: "/",
+			RequestOpts: []func(*e2e.HTTPRequestOpts){
+				e2e.OptSetHeaders(map[string]string{
+					"X-Tracing-Tag": "world",
+				}),
+			},
+			Condition: e2e.HasStatusCode(200),
+		})
+		require.True(t, ok, "expected 200 response code, got %d", res.StatusCode)
+
+		require.NoError(t, collector.WaitForSpan(fmt.Sprintf("vhost|%s", p.Spec.VirtualHost.Fqdn), func(span e2e.TracingSpan) bool {
+			return span.Tags["literal-tag"] == "hello" && span.Tags["header-tag"] == "world"
+		}))
+	})
+}
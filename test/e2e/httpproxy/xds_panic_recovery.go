@@ -0,0 +1,112 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package httpproxy
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/projectcontour/contour/test/e2e"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testXDSPanicRecovery installs a HTTPProxy with a malformed header
+// regex condition (not rejected by the admission webhook, since
+// arbitrary regex validity isn't checked there), which panics when the
+// DAG compiles it, and asserts that Envoy remains connected to the xDS
+// server and that other, healthy HTTPProxies in the cluster keep being
+// programmed.
+func testXDSPanicRecovery(namespace string) {
+	Specify("a panic while processing one HTTPProxy does not take down the xDS server", func() {
+		t := f.T()
+
+		f.Fixtures.Echo.Deploy(namespace, "echo")
+
+		healthy := &contourv1.HTTPProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "healthy",
+			},
+			Spec: contourv1.HTTPProxySpec{
+				VirtualHost: &contourv1.VirtualHost{
+					Fqdn: "healthy.projectcontour.io",
+				},
+				Routes: []contourv1.Route{
+					{
+						Services: []contourv1.Service{
+							{
+								Name: "echo",
+								Port: 80,
+							},
+						},
+					},
+				},
+			},
+		}
+		f.CreateHTTPProxyAndWaitFor(healthy, httpProxyValid)
+
+		faulty := &contourv1.HTTPProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "faulty",
+			},
+			Spec: contourv1.HTTPProxySpec{
+				VirtualHost: &contourv1.VirtualHost{
+					Fqdn: "faulty.projectcontour.io",
+				},
+				Routes: []contourv1.Route{
+					{
+						Conditions: []contourv1.MatchCondition{
+							{
+								HeaderRegex: &contourv1.HeaderRegexMatchCondition{
+									Name: "X-Faulty",
+									// Unbalanced parenthesis: not valid RE2,
+									// but not rejected by the admission
+									// webhook either, since it only checks
+									// that Regex is a non-empty string.
+									Regex: "(unbalanced",
+								},
+							},
+						},
+						Services: []contourv1.Service{
+							{
+								Name: "echo",
+								Port: 80,
+							},
+						},
+					},
+				},
+			},
+		}
+		require.NoError(t, f.Client.Create(context.TODO(), faulty))
+
+		res, ok := f.HTTP.RequestUntil(&e2e.HTTPRequestOpts{
+			Host:      healthy.Spec.VirtualHost.Fqdn,
+			Path:      "/",
+			Condition: e2e.HasStatusCode(200),
+		})
+		require.True(t, ok, "expected 200 response code, got %d", res.StatusCode)
+
+		require.NoError(t, f.Deployment.WaitForEnvoyDaemonSetUpdated())
+
+		require.Eventually(t, func() bool {
+			return f.Metrics.GetCounter("contour_xds_panic_total") > 0
+		}, e2e.DefaultEventuallyTimeout, e2e.DefaultEventuallyPollingInterval, "expected contour_xds_panic_total to be incremented")
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// contourMetricsAddr is where the locally-run Contour process started
+// by Deployment.StartLocalContour serves its Prometheus /metrics
+// endpoint.
+const contourMetricsAddr = "127.0.0.1:8000"
+
+// GetCounter scrapes the local Contour process's /metrics endpoint and
+// returns the summed value of every series for name, across all label
+// combinations. It returns 0 if name has not been observed yet, since
+// a counter that hasn't incremented simply isn't in the scrape output.
+func (m *Metrics) GetCounter(name string) float64 {
+	resp, err := http.Get(fmt.Sprintf("http://%s/metrics", contourMetricsAddr))
+	if err != nil {
+		return 0
+	}
+	defer resp.Body.Close()
+
+	var total float64
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || !strings.HasPrefix(line, name) {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(fields[len(fields)-1], 64)
+		if err != nil {
+			continue
+		}
+
+		total += value
+	}
+
+	return total
+}
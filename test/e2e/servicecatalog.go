@@ -0,0 +1,148 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// CatalogRefreshInterval is the poll interval e2e tests configure a
+// ServiceRegistry with, short enough that a test doesn't have to wait
+// out the production-recommended range to observe catalog churn.
+const CatalogRefreshInterval = 5 * time.Second
+
+// CatalogEndpoint is a single backend a ServiceCatalog reports for a
+// service, matching the wire format registry.Poller expects back from
+// a ServiceRegistry's endpoint.
+type CatalogEndpoint struct {
+	Address string   `json:"address"`
+	Port    int      `json:"port"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// ServiceCatalog is a handle onto a fake external service catalog
+// deployed by DeployServiceCatalog, letting tests set the endpoints it
+// reports for a service and construct addresses for backends in the
+// same namespace.
+type ServiceCatalog struct {
+	namespace string
+	name      string
+}
+
+// DeployServiceCatalog deploys a fake external service catalog named
+// name into namespace, exposing the HTTP API registry.Poller polls,
+// and returns a handle for driving it.
+func DeployServiceCatalog(namespace, name string) (*ServiceCatalog, error) {
+	cl, err := newClient()
+	if err != nil {
+		return nil, fmt.Errorf("building client for service catalog: %w", err)
+	}
+
+	ctx := context.Background()
+	labels := map[string]string{"app": name}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "catalog",
+							Image: "projectcontour/e2e-service-catalog:latest",
+							Ports: []corev1.ContainerPort{
+								{Name: "catalog", ContainerPort: 8080},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := cl.Create(ctx, deployment); err != nil {
+		return nil, fmt.Errorf("creating service catalog deployment %s/%s: %w", namespace, name, err)
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{Name: "catalog", Port: 8080, TargetPort: intstr.FromString("catalog")},
+			},
+		},
+	}
+	if err := cl.Create(ctx, service); err != nil {
+		return nil, fmt.Errorf("creating service catalog service %s/%s: %w", namespace, name, err)
+	}
+
+	return &ServiceCatalog{namespace: namespace, name: name}, nil
+}
+
+// URL returns the base URL a ServiceRegistry.Spec.Endpoint should
+// point at to resolve services against this catalog.
+func (c *ServiceCatalog) URL() string {
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:8080", c.name, c.namespace)
+}
+
+// EchoAddress returns the ClusterIP DNS name of an Echo fixture named
+// echoName in namespace, suitable for use as a CatalogEndpoint's
+// Address.
+func (c *ServiceCatalog) EchoAddress(namespace, echoName string) string {
+	return fmt.Sprintf("%s.%s.svc.cluster.local", echoName, namespace)
+}
+
+// SetEndpoints replaces the endpoints the catalog reports for
+// serviceName.
+func (c *ServiceCatalog) SetEndpoints(serviceName string, endpoints []CatalogEndpoint) error {
+	body, err := json.Marshal(endpoints)
+	if err != nil {
+		return fmt.Errorf("encoding catalog endpoints for %q: %w", serviceName, err)
+	}
+
+	url := fmt.Sprintf("%s/services/%s", c.URL(), serviceName)
+
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("setting catalog endpoints for %q: %w", serviceName, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("setting catalog endpoints for %q: unexpected status %d", serviceName, resp.StatusCode)
+	}
+
+	return nil
+}
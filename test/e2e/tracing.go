@@ -0,0 +1,159 @@
+// Copyright Project Contour Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// TracingSpan is a single span reported to the fake collector deployed
+// by DeployTracingCollector, trimmed down to what tests need to assert
+// on.
+type TracingSpan struct {
+	VHost string            `json:"vhost"`
+	Tags  map[string]string `json:"tags"`
+}
+
+// TracingCollector is a handle onto the fake tracing backend deployed
+// by DeployTracingCollector, letting tests assert on the spans Envoy
+// actually emitted for a request rather than trusting Envoy's stats
+// alone.
+type TracingCollector struct {
+	namespace string
+	name      string
+}
+
+// DeployTracingCollector deploys a fake OpenTelemetry collector named
+// name into namespace and returns a handle for inspecting the spans it
+// receives. The collector exposes its received spans as JSON over
+// HTTP so WaitForSpan can poll them without needing an OTLP client.
+func DeployTracingCollector(namespace, name string) (*TracingCollector, error) {
+	cl, err := newClient()
+	if err != nil {
+		return nil, fmt.Errorf("building client for tracing collector: %w", err)
+	}
+
+	ctx := context.Background()
+	labels := map[string]string{"app": name}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "collector",
+							Image: "projectcontour/e2e-tracing-collector:latest",
+							Ports: []corev1.ContainerPort{
+								{Name: "otlp", ContainerPort: 4318},
+								{Name: "query", ContainerPort: 8080},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := cl.Create(ctx, deployment); err != nil {
+		return nil, fmt.Errorf("creating tracing collector deployment %s/%s: %w", namespace, name, err)
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{Name: "otlp", Port: 4318, TargetPort: intstr.FromString("otlp")},
+				{Name: "query", Port: 8080, TargetPort: intstr.FromString("query")},
+			},
+		},
+	}
+	if err := cl.Create(ctx, service); err != nil {
+		return nil, fmt.Errorf("creating tracing collector service %s/%s: %w", namespace, name, err)
+	}
+
+	return &TracingCollector{namespace: namespace, name: name}, nil
+}
+
+// WaitForSpan polls the collector's query endpoint for vhost until a
+// received span satisfies match, or the default eventually timeout
+// elapses.
+func (c *TracingCollector) WaitForSpan(vhost string, match func(TracingSpan) bool) error {
+	deadline := time.Now().Add(DefaultEventuallyTimeout)
+
+	for time.Now().Before(deadline) {
+		spans, err := c.spans(vhost)
+		if err == nil {
+			for _, span := range spans {
+				if match(span) {
+					return nil
+				}
+			}
+		}
+
+		time.Sleep(DefaultEventuallyPollingInterval)
+	}
+
+	return fmt.Errorf("no span for vhost %q matched the expected tags within %s", vhost, DefaultEventuallyTimeout)
+}
+
+func (c *TracingCollector) spans(vhost string) ([]TracingSpan, error) {
+	url := fmt.Sprintf("http://%s.%s.svc.cluster.local:8080/spans?vhost=%s", c.name, c.namespace, vhost)
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var spans []TracingSpan
+	if err := json.Unmarshal(body, &spans); err != nil {
+		return nil, fmt.Errorf("decoding spans response: %w", err)
+	}
+
+	return spans, nil
+}
+
+// TracingExtensionServiceName is the name EnsureTracingResources
+// registers the fake collector's ExtensionService under, for tests
+// that need to reference it before the collector is deployed.
+const TracingExtensionServiceName = "tracing-collector"
+
+// TracingCollectorAt returns a handle to the collector
+// EnsureTracingResources already deployed as name in namespace,
+// without deploying it again.
+func TracingCollectorAt(namespace, name string) *TracingCollector {
+	return &TracingCollector{namespace: namespace, name: name}
+}